@@ -123,8 +123,8 @@ func initDfpath(cfg *config.ServerConfig) (dfpath.Dfpath, error) {
 func runScheduler(ctx context.Context, d dfpath.Dfpath) error {
 	logger.Infof("Version:\n%s", version.Version())
 
-	// scheduler config values.
-	s, _ := yaml.Marshal(cfg)
+	// scheduler config values, secrets are redacted.
+	s, _ := yaml.Marshal(cfg.Redact())
 
 	logger.Infof("scheduler configuration:\n%s", string(s))
 
@@ -52,6 +52,14 @@ func TestGetApiGroupName(t *testing.T) {
 				assert.Equal(data, "users")
 			},
 		},
+		{
+			name: `path is /api/v1/scheduler-clusters/1/schedulers/2`,
+			path: "/api/v1/scheduler-clusters/1/schedulers/2",
+			expect: func(t *testing.T, data string, err error) {
+				assert := assert.New(t)
+				assert.Equal(data, "scheduler-clusters")
+			},
+		},
 		{
 			name: `path is /api/user`,
 			path: "/api/user",
@@ -87,10 +95,30 @@ func TestHTTPMethodToAction(t *testing.T) {
 			method:         "GET",
 			expectedAction: ReadAction,
 		},
+		{
+			method:         "HEAD",
+			expectedAction: ReadAction,
+		},
+		{
+			method:         "OPTIONS",
+			expectedAction: ReadAction,
+		},
 		{
 			method:         "POST",
 			expectedAction: AllAction,
 		},
+		{
+			method:         "PUT",
+			expectedAction: AllAction,
+		},
+		{
+			method:         "PATCH",
+			expectedAction: AllAction,
+		},
+		{
+			method:         "DELETE",
+			expectedAction: AllAction,
+		},
 		{
 			method:         "UNKNOWN",
 			expectedAction: ReadAction,
@@ -0,0 +1,66 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	// algorithmsLock protects algorithms.
+	algorithmsLock sync.RWMutex
+
+	// algorithms is the set of scheduling algorithms known by the scheduler.
+	algorithms = map[string]struct{}{
+		DefaultSchedulerAlgorithm: {},
+		MLSchedulerAlgorithm:      {},
+		PluginSchedulerAlgorithm:  {},
+	}
+)
+
+// RegisterAlgorithm registers the name of a scheduling algorithm,
+// so that it is accepted by the scheduler configuration.
+func RegisterAlgorithm(name string) {
+	algorithmsLock.Lock()
+	defer algorithmsLock.Unlock()
+
+	algorithms[name] = struct{}{}
+}
+
+// IsAlgorithmRegistered returns whether the scheduling algorithm is registered.
+func IsAlgorithmRegistered(name string) bool {
+	algorithmsLock.RLock()
+	defer algorithmsLock.RUnlock()
+
+	_, ok := algorithms[name]
+	return ok
+}
+
+// Algorithms returns the sorted names of registered scheduling algorithms.
+func Algorithms() []string {
+	algorithmsLock.RLock()
+	defer algorithmsLock.RUnlock()
+
+	names := make([]string, 0, len(algorithms))
+	for name := range algorithms {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
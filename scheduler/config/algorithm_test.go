@@ -0,0 +1,51 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlgorithm_RegisterAlgorithm(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsAlgorithmRegistered(DefaultSchedulerAlgorithm))
+	assert.True(IsAlgorithmRegistered(MLSchedulerAlgorithm))
+	assert.True(IsAlgorithmRegistered(PluginSchedulerAlgorithm))
+	assert.False(IsAlgorithmRegistered("foo"))
+
+	RegisterAlgorithm("foo")
+	defer func() {
+		algorithmsLock.Lock()
+		delete(algorithms, "foo")
+		algorithmsLock.Unlock()
+	}()
+
+	assert.True(IsAlgorithmRegistered("foo"))
+	assert.Equal([]string{"default", "foo", "ml", "plugin"}, Algorithms())
+
+	cfg := New()
+	cfg.Manager = mockManagerConfig
+	cfg.Job = mockJobConfig
+	cfg.Scheduler.Algorithm = "foo"
+	if err := cfg.Convert(); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NoError(cfg.Validate())
+}
@@ -20,9 +20,12 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"regexp"
+	"strings"
 	"time"
 
 	"d7y.io/dragonfly/v2/cmd/dependency/base"
+	logger "d7y.io/dragonfly/v2/internal/dflog"
 	"d7y.io/dragonfly/v2/pkg/net/fqdn"
 	"d7y.io/dragonfly/v2/pkg/net/ip"
 	"d7y.io/dragonfly/v2/pkg/rpc"
@@ -293,6 +296,62 @@ type CertSpec struct {
 	ValidityPeriod time.Duration `mapstructure:"validityPeriod" yaml:"validityPeriod"`
 }
 
+// dnsLabelRegexp matches a single label of a dns name.
+var dnsLabelRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// validateEntries checks that every ip address and dns name of the certificate
+// is well-formed and not duplicated.
+func (c *CertSpec) validateEntries() error {
+	ips := make([]net.IP, 0, len(c.IPAddresses))
+	for _, ip := range c.IPAddresses {
+		if ip == nil {
+			return errors.New("certSpec ipAddresses contains invalid ip address")
+		}
+
+		for _, existing := range ips {
+			if existing.Equal(ip) {
+				return fmt.Errorf("certSpec ipAddresses contains duplicate ip address %s", ip.String())
+			}
+		}
+
+		ips = append(ips, ip)
+	}
+
+	dnsNames := make(map[string]struct{}, len(c.DNSNames))
+	for _, dnsName := range c.DNSNames {
+		if !isValidDNSName(dnsName) {
+			return fmt.Errorf("certSpec dnsNames contains invalid dns name %q", dnsName)
+		}
+
+		normalized := strings.ToLower(strings.TrimSuffix(dnsName, "."))
+		if _, ok := dnsNames[normalized]; ok {
+			return fmt.Errorf("certSpec dnsNames contains duplicate dns name %s", dnsName)
+		}
+
+		dnsNames[normalized] = struct{}{}
+	}
+
+	return nil
+}
+
+// isValidDNSName reports whether name is a syntactically valid dns name,
+// a leading wildcard label is allowed.
+func isValidDNSName(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > 253 {
+		return false
+	}
+
+	name = strings.TrimPrefix(name, "*.")
+	for _, label := range strings.Split(name, ".") {
+		if !dnsLabelRegexp.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
 type NetworkConfig struct {
 	// EnableIPv6 enables ipv6 for server.
 	EnableIPv6 bool `mapstructure:"enableIPv6" yaml:"enableIPv6"`
@@ -447,6 +506,11 @@ func (cfg *Config) Validate() error {
 		return errors.New("scheduler requires parameter algorithm")
 	}
 
+	if !IsAlgorithmRegistered(cfg.Scheduler.Algorithm) {
+		return fmt.Errorf("scheduler algorithm %s is not registered, available algorithms are %s",
+			cfg.Scheduler.Algorithm, strings.Join(Algorithms(), ", "))
+	}
+
 	if cfg.Scheduler.BackToSourceCount == 0 {
 		return errors.New("scheduler requires parameter backToSourceCount")
 	}
@@ -487,6 +551,16 @@ func (cfg *Config) Validate() error {
 		return errors.New("scheduler requires parameter hostTTL")
 	}
 
+	// Peer ttl and host ttl are both checked by the peer gc,
+	// so they should not be less than the interval of peer gc.
+	if cfg.Scheduler.GC.PeerTTL < cfg.Scheduler.GC.PeerGCInterval {
+		return errors.New("scheduler requires parameter peerTTL to be greater than or equal to peerGCInterval")
+	}
+
+	if cfg.Scheduler.GC.HostTTL < cfg.Scheduler.GC.PeerGCInterval {
+		return errors.New("scheduler requires parameter hostTTL to be greater than or equal to peerGCInterval")
+	}
+
 	if cfg.DynConfig.RefreshInterval <= 0 {
 		return errors.New("dynconfig requires parameter refreshInterval")
 	}
@@ -564,6 +638,10 @@ func (cfg *Config) Validate() error {
 			return errors.New("certSpec requires parameter dnsNames")
 		}
 
+		if err := cfg.Security.CertSpec.validateEntries(); err != nil {
+			return err
+		}
+
 		if cfg.Security.CertSpec.ValidityPeriod <= 0 {
 			return errors.New("certSpec requires parameter validityPeriod")
 		}
@@ -605,27 +683,43 @@ func (cfg *Config) Validate() error {
 func (cfg *Config) Convert() error {
 	// TODO Compatible with deprecated fields backSourceCount.
 	if cfg.Scheduler.BackSourceCount != 0 {
+		warnDeprecatedField("scheduler.backSourceCount", "scheduler.backToSourceCount")
 		cfg.Scheduler.BackToSourceCount = cfg.Scheduler.BackSourceCount
 	}
 
 	// TODO Compatible with deprecated fields retryBackSourceLimit.
 	if cfg.Scheduler.RetryBackSourceLimit != 0 {
+		warnDeprecatedField("scheduler.retryBackSourceLimit", "scheduler.retryBackToSourceLimit")
 		cfg.Scheduler.RetryBackToSourceLimit = cfg.Scheduler.RetryBackSourceLimit
 	}
 
 	// TODO Compatible with deprecated fields host and port.
+	if cfg.Job.Redis.Host != "" {
+		warnDeprecatedField("job.redis.host", "job.redis.addrs")
+	}
+
+	if cfg.Job.Redis.Port != 0 {
+		warnDeprecatedField("job.redis.port", "job.redis.addrs")
+	}
+
 	if len(cfg.Job.Redis.Addrs) == 0 && cfg.Job.Redis.Host != "" && cfg.Job.Redis.Port > 0 {
 		cfg.Job.Redis.Addrs = []string{fmt.Sprintf("%s:%d", cfg.Job.Redis.Host, cfg.Job.Redis.Port)}
 	}
 
 	// TODO Compatible with deprecated fields ip.
-	if cfg.Server.IP != "" && cfg.Server.AdvertiseIP == nil {
-		cfg.Server.AdvertiseIP = net.ParseIP(cfg.Server.IP)
+	if cfg.Server.IP != "" {
+		warnDeprecatedField("server.ip", "server.advertiseIP")
+		if cfg.Server.AdvertiseIP == nil {
+			cfg.Server.AdvertiseIP = net.ParseIP(cfg.Server.IP)
+		}
 	}
 
 	// TODO Compatible with deprecated fields listen.
-	if cfg.Server.Listen != "" && cfg.Server.ListenIP == nil {
-		cfg.Server.ListenIP = net.ParseIP(cfg.Server.Listen)
+	if cfg.Server.Listen != "" {
+		warnDeprecatedField("server.listen", "server.listenIP")
+		if cfg.Server.ListenIP == nil {
+			cfg.Server.ListenIP = net.ParseIP(cfg.Server.Listen)
+		}
 	}
 
 	if cfg.Server.AdvertiseIP == nil {
@@ -646,3 +740,23 @@ func (cfg *Config) Convert() error {
 
 	return nil
 }
+
+// Redact returns a copy of the config with secrets redacted,
+// so that the resolved config can be exposed safely.
+func (cfg *Config) Redact() *Config {
+	redacted := *cfg
+	if redacted.Job.Redis.Password != "" {
+		redacted.Job.Redis.Password = RedactedValue
+	}
+
+	if redacted.Security.CACert != "" {
+		redacted.Security.CACert = types.PEMContent(RedactedValue)
+	}
+
+	return &redacted
+}
+
+// warnDeprecatedField logs that a deprecated config field is set and names its replacement.
+func warnDeprecatedField(field, replacement string) {
+	logger.With("field", field, "replacement", replacement).Warnf("config field %s is deprecated, please use %s instead", field, replacement)
+}
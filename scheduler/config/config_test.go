@@ -23,8 +23,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 	"gopkg.in/yaml.v3"
 
+	logger "d7y.io/dragonfly/v2/internal/dflog"
 	"d7y.io/dragonfly/v2/pkg/rpc"
 	"d7y.io/dragonfly/v2/pkg/types"
 )
@@ -266,6 +270,19 @@ func TestConfig_Validate(t *testing.T) {
 				assert.EqualError(err, "scheduler requires parameter algorithm")
 			},
 		},
+		{
+			name:   "scheduler algorithm is not registered",
+			config: New(),
+			mock: func(cfg *Config) {
+				cfg.Manager = mockManagerConfig
+				cfg.Job = mockJobConfig
+				cfg.Scheduler.Algorithm = "foo"
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "scheduler algorithm foo is not registered, available algorithms are default, ml, plugin")
+			},
+		},
 		{
 			name:   "scheduler requires parameter backToSourceCount",
 			config: New(),
@@ -396,6 +413,34 @@ func TestConfig_Validate(t *testing.T) {
 				assert.EqualError(err, "scheduler requires parameter hostTTL")
 			},
 		},
+		{
+			name:   "scheduler requires parameter peerTTL to be greater than or equal to peerGCInterval",
+			config: New(),
+			mock: func(cfg *Config) {
+				cfg.Manager = mockManagerConfig
+				cfg.Job = mockJobConfig
+				cfg.Scheduler.GC.PeerGCInterval = 2 * time.Minute
+				cfg.Scheduler.GC.PeerTTL = 1 * time.Minute
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "scheduler requires parameter peerTTL to be greater than or equal to peerGCInterval")
+			},
+		},
+		{
+			name:   "scheduler requires parameter hostTTL to be greater than or equal to peerGCInterval",
+			config: New(),
+			mock: func(cfg *Config) {
+				cfg.Manager = mockManagerConfig
+				cfg.Job = mockJobConfig
+				cfg.Scheduler.GC.PeerGCInterval = 2 * time.Hour
+				cfg.Scheduler.GC.HostTTL = 1 * time.Hour
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "scheduler requires parameter hostTTL to be greater than or equal to peerGCInterval")
+			},
+		},
 		{
 			name:   "dynconfig requires parameter refreshInterval",
 			config: New(),
@@ -635,6 +680,62 @@ func TestConfig_Validate(t *testing.T) {
 				assert.EqualError(err, "certSpec requires parameter dnsNames")
 			},
 		},
+		{
+			name:   "certSpec ipAddresses contains invalid ip address",
+			config: New(),
+			mock: func(cfg *Config) {
+				cfg.Manager = mockManagerConfig
+				cfg.Job = mockJobConfig
+				cfg.Security = mockSecurityConfig
+				cfg.Security.CertSpec.IPAddresses = []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("foo")}
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "certSpec ipAddresses contains invalid ip address")
+			},
+		},
+		{
+			name:   "certSpec ipAddresses contains duplicate ip address",
+			config: New(),
+			mock: func(cfg *Config) {
+				cfg.Manager = mockManagerConfig
+				cfg.Job = mockJobConfig
+				cfg.Security = mockSecurityConfig
+				cfg.Security.CertSpec.IPAddresses = []net.IP{net.ParseIP("127.0.0.1"), net.IPv4(127, 0, 0, 1).To4()}
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "certSpec ipAddresses contains duplicate ip address 127.0.0.1")
+			},
+		},
+		{
+			name:   "certSpec dnsNames contains invalid dns name",
+			config: New(),
+			mock: func(cfg *Config) {
+				cfg.Manager = mockManagerConfig
+				cfg.Job = mockJobConfig
+				cfg.Security = mockSecurityConfig
+				cfg.Security.CertSpec.DNSNames = []string{"dragonfly-scheduler", "-foo.bar"}
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, `certSpec dnsNames contains invalid dns name "-foo.bar"`)
+			},
+		},
+		{
+			name:   "certSpec dnsNames contains duplicate dns name",
+			config: New(),
+			mock: func(cfg *Config) {
+				cfg.Manager = mockManagerConfig
+				cfg.Job = mockJobConfig
+				cfg.Security = mockSecurityConfig
+				cfg.Security.CertSpec.DNSNames = []string{"dragonfly-scheduler", "*.dragonfly-system.svc", "Dragonfly-Scheduler."}
+			},
+			expect: func(t *testing.T, err error) {
+				assert := assert.New(t)
+				assert.EqualError(err, "certSpec dnsNames contains duplicate dns name Dragonfly-Scheduler.")
+			},
+		},
 		{
 			name:   "certSpec requires parameter validityPeriod",
 			config: New(),
@@ -755,3 +856,100 @@ func TestConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_Convert(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+		mock   func(cfg *Config)
+		expect func(t *testing.T, cfg *Config, logs *observer.ObservedLogs)
+	}{
+		{
+			name:   "deprecated fields are not set",
+			config: New(),
+			mock:   func(cfg *Config) {},
+			expect: func(t *testing.T, cfg *Config, logs *observer.ObservedLogs) {
+				assert := assert.New(t)
+				assert.Equal(0, logs.Len())
+			},
+		},
+		{
+			name:   "deprecated fields are set",
+			config: New(),
+			mock: func(cfg *Config) {
+				cfg.Scheduler.BackSourceCount = 5
+				cfg.Scheduler.RetryBackSourceLimit = 4
+				cfg.Job.Redis.Host = "127.0.0.1"
+				cfg.Job.Redis.Port = 6379
+				cfg.Server.IP = "127.0.0.1"
+				cfg.Server.Listen = "0.0.0.0"
+			},
+			expect: func(t *testing.T, cfg *Config, logs *observer.ObservedLogs) {
+				assert := assert.New(t)
+				assert.Equal(5, cfg.Scheduler.BackToSourceCount)
+				assert.Equal(4, cfg.Scheduler.RetryBackToSourceLimit)
+				assert.EqualValues([]string{"127.0.0.1:6379"}, cfg.Job.Redis.Addrs)
+				assert.Equal("127.0.0.1", cfg.Server.AdvertiseIP.String())
+				assert.Equal("0.0.0.0", cfg.Server.ListenIP.String())
+
+				replacements := map[string]string{}
+				for _, entry := range logs.FilterLevelExact(zapcore.WarnLevel).All() {
+					fields := entry.ContextMap()
+					replacements[fields["field"].(string)] = fields["replacement"].(string)
+				}
+
+				assert.Equal(map[string]string{
+					"scheduler.backSourceCount":      "scheduler.backToSourceCount",
+					"scheduler.retryBackSourceLimit": "scheduler.retryBackToSourceLimit",
+					"job.redis.host":                 "job.redis.addrs",
+					"job.redis.port":                 "job.redis.addrs",
+					"server.ip":                      "server.advertiseIP",
+					"server.listen":                  "server.listenIP",
+				}, replacements)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.WarnLevel)
+			coreLogger := logger.CoreLogger
+			logger.SetCoreLogger(zap.New(core).Sugar())
+			defer logger.SetCoreLogger(coreLogger)
+
+			tc.mock(tc.config)
+			if err := tc.config.Convert(); err != nil {
+				t.Fatal(err)
+			}
+
+			tc.expect(t, tc.config, logs)
+		})
+	}
+}
+
+func TestConfig_Redact(t *testing.T) {
+	cfg := New()
+	cfg.Job = mockJobConfig
+	cfg.Security = mockSecurityConfig
+	cfg.Job.Redis.Host = "127.0.0.1"
+	cfg.Job.Redis.Port = 6379
+	if err := cfg.Convert(); err != nil {
+		t.Fatal(err)
+	}
+
+	redacted := cfg.Redact()
+	assert := assert.New(t)
+	assert.Equal(RedactedValue, redacted.Job.Redis.Password)
+	assert.Equal(types.PEMContent(RedactedValue), redacted.Security.CACert)
+	assert.Equal(cfg.Job.Redis.Addrs, redacted.Job.Redis.Addrs)
+	assert.Equal(cfg.Server.AdvertiseIP, redacted.Server.AdvertiseIP)
+
+	// Redact should not modify the original config.
+	assert.Equal("bar", cfg.Job.Redis.Password)
+	assert.Equal(types.PEMContent("foo"), cfg.Security.CACert)
+
+	out, err := yaml.Marshal(redacted)
+	assert.NoError(err)
+	assert.NotContains(string(out), "password: bar")
+	assert.NotContains(string(out), "caCert: foo")
+}
@@ -52,6 +52,12 @@ const (
 	// DefaultSchedulerAlgorithm is default algorithm for scheduler.
 	DefaultSchedulerAlgorithm = "default"
 
+	// MLSchedulerAlgorithm is machine learning algorithm for scheduler.
+	MLSchedulerAlgorithm = "ml"
+
+	// PluginSchedulerAlgorithm is plugin extension algorithm for scheduler.
+	PluginSchedulerAlgorithm = "plugin"
+
 	// DefaultSchedulerBackToSourceCount is default back-to-source count for scheduler.
 	DefaultSchedulerBackToSourceCount = 3
 
@@ -179,3 +185,8 @@ const (
 	// DefaultTrainerInterval is the default interval of training.
 	DefaultTrainerInterval = 7 * 24 * time.Hour
 )
+
+const (
+	// RedactedValue is the placeholder of secrets in the redacted config.
+	RedactedValue = "******"
+)
@@ -17,18 +17,21 @@
 package evaluator
 
 import (
+	"sync"
+
+	"d7y.io/dragonfly/v2/scheduler/config"
 	"d7y.io/dragonfly/v2/scheduler/resource"
 )
 
 const (
 	// DefaultAlgorithm is a rule-based scheduling algorithm.
-	DefaultAlgorithm = "default"
+	DefaultAlgorithm = config.DefaultSchedulerAlgorithm
 
 	// MLAlgorithm is a machine learning scheduling algorithm.
-	MLAlgorithm = "ml"
+	MLAlgorithm = config.MLSchedulerAlgorithm
 
 	// PluginAlgorithm is a scheduling algorithm based on plugin extension.
-	PluginAlgorithm = "plugin"
+	PluginAlgorithm = config.PluginSchedulerAlgorithm
 )
 
 type Evaluator interface {
@@ -39,16 +42,49 @@ type Evaluator interface {
 	IsBadNode(peer *resource.Peer) bool
 }
 
+// Constructor creates an evaluator of the scheduling algorithm,
+// pluginDir is the directory of scheduler plugins.
+type Constructor func(pluginDir string) (Evaluator, error)
+
+var (
+	// constructorsLock protects constructors.
+	constructorsLock sync.RWMutex
+
+	// constructors maps the scheduling algorithm to its evaluator constructor.
+	constructors = map[string]Constructor{
+		DefaultAlgorithm: newEvaluatorBase,
+		// TODO Implement MLAlgorithm.
+		MLAlgorithm:     newEvaluatorBase,
+		PluginAlgorithm: LoadPlugin,
+	}
+)
+
+// RegisterAlgorithm registers the evaluator constructor of the scheduling algorithm,
+// and makes the algorithm valid in the scheduler configuration.
+func RegisterAlgorithm(name string, constructor Constructor) {
+	constructorsLock.Lock()
+	defer constructorsLock.Unlock()
+
+	constructors[name] = constructor
+	config.RegisterAlgorithm(name)
+}
+
+// New returns the evaluator of the scheduling algorithm. If the algorithm is not
+// registered or the evaluator fails to be created, the base evaluator is returned.
 func New(algorithm string, pluginDir string) Evaluator {
-	switch algorithm {
-	case PluginAlgorithm:
-		if plugin, err := LoadPlugin(pluginDir); err == nil {
-			return plugin
+	constructorsLock.RLock()
+	constructor, ok := constructors[algorithm]
+	constructorsLock.RUnlock()
+
+	if ok {
+		if e, err := constructor(pluginDir); err == nil {
+			return e
 		}
-	// TODO Implement MLAlgorithm.
-	case MLAlgorithm, DefaultAlgorithm:
-		return NewEvaluatorBase()
 	}
 
 	return NewEvaluatorBase()
 }
+
+func newEvaluatorBase(string) (Evaluator, error) {
+	return NewEvaluatorBase(), nil
+}
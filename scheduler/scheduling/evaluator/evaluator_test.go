@@ -17,10 +17,13 @@
 package evaluator
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"d7y.io/dragonfly/v2/scheduler/config"
 )
 
 func TestEvaluator_New(t *testing.T) {
@@ -70,3 +73,54 @@ func TestEvaluator_New(t *testing.T) {
 		})
 	}
 }
+
+type fooEvaluator struct {
+	Evaluator
+}
+
+func TestEvaluator_RegisterAlgorithm(t *testing.T) {
+	tests := []struct {
+		name        string
+		algorithm   string
+		constructor Constructor
+		expect      func(t *testing.T, e any)
+	}{
+		{
+			name:      "register algorithm",
+			algorithm: "foo",
+			constructor: func(string) (Evaluator, error) {
+				return &fooEvaluator{}, nil
+			},
+			expect: func(t *testing.T, e any) {
+				assert := assert.New(t)
+				assert.Equal(reflect.TypeOf(e).Elem().Name(), "fooEvaluator")
+				assert.True(config.IsAlgorithmRegistered("foo"))
+			},
+		},
+		{
+			name:      "register algorithm whose constructor fails",
+			algorithm: "bar",
+			constructor: func(string) (Evaluator, error) {
+				return nil, errors.New("foo")
+			},
+			expect: func(t *testing.T, e any) {
+				assert := assert.New(t)
+				assert.Equal(reflect.TypeOf(e).Elem().Name(), "evaluatorBase")
+				assert.True(config.IsAlgorithmRegistered("bar"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterAlgorithm(tc.algorithm, tc.constructor)
+			defer func() {
+				constructorsLock.Lock()
+				delete(constructors, tc.algorithm)
+				constructorsLock.Unlock()
+			}()
+
+			tc.expect(t, New(tc.algorithm, "."))
+		})
+	}
+}